@@ -0,0 +1,80 @@
+package clients
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/matthieugouel/sdk/go/models"
+	"github.com/matthieugouel/sdk/go/session"
+)
+
+// alertConfigURI is the Avi REST collection endpoint for AlertConfig objects.
+const alertConfigURI = "/alertconfig"
+
+// AlertConfigClient performs CRUD operations on AlertConfig objects over an
+// authenticated AviSession.
+type AlertConfigClient struct {
+	session *session.AviSession
+}
+
+// NewAlertConfigClient returns a client bound to session.
+func NewAlertConfigClient(session *session.AviSession) *AlertConfigClient {
+	return &AlertConfigClient{session: session}
+}
+
+// CreateAlertConfig creates obj on the controller and returns the object as
+// stored, including server-assigned fields such as UUID and URL.
+func (c *AlertConfigClient) CreateAlertConfig(obj *models.AlertConfig) (*models.AlertConfig, error) {
+	result := &models.AlertConfig{}
+	if err := c.session.Post(alertConfigURI, obj, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetAlertConfigByName fetches the AlertConfig named name.
+func (c *AlertConfigClient) GetAlertConfigByName(name string) (*models.AlertConfig, error) {
+	// A GET on the collection returns its matches wrapped in a results list.
+	result := &struct {
+		Results []*models.AlertConfig `json:"results"`
+	}{}
+	uri := fmt.Sprintf("%s?%s", alertConfigURI, url.Values{"name": {name}}.Encode())
+	if err := c.session.Get(uri, result); err != nil {
+		return nil, err
+	}
+	if len(result.Results) == 0 {
+		return nil, fmt.Errorf("clients: no AlertConfig named %q", name)
+	}
+	return result.Results[0], nil
+}
+
+// UpdateAlertConfig replaces the AlertConfig identified by obj.UUID with obj.
+func (c *AlertConfigClient) UpdateAlertConfig(obj *models.AlertConfig) (*models.AlertConfig, error) {
+	if obj.UUID == "" {
+		return nil, fmt.Errorf("clients: UpdateAlertConfig requires obj.UUID")
+	}
+	result := &models.AlertConfig{}
+	uri := fmt.Sprintf("%s/%s", alertConfigURI, obj.UUID)
+	if err := c.session.Put(uri, obj, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteAlertConfig deletes the AlertConfig with the given uuid.
+func (c *AlertConfigClient) DeleteAlertConfig(uuid string) error {
+	uri := fmt.Sprintf("%s/%s", alertConfigURI, uuid)
+	return c.session.Delete(uri)
+}
+
+// PatchAlertConfig applies op to fields on the AlertConfig identified by
+// uuid, sending only those fields rather than a full GET/modify/PUT round
+// trip. See session.Patch for the add/replace/delete semantics of op.
+func (c *AlertConfigClient) PatchAlertConfig(uuid string, op session.PatchOp, fields map[string]interface{}) (*models.AlertConfig, error) {
+	result := &models.AlertConfig{}
+	uri := fmt.Sprintf("%s/%s", alertConfigURI, uuid)
+	if err := c.session.Patch(uri, op, fields, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,135 @@
+package clients
+
+import "github.com/matthieugouel/sdk/go/models"
+
+// AlertConfigBuilder composes an AlertConfig from its constituent rules
+// without requiring callers to hand-assemble the nested MetricsRule/
+// SysEventRule/ConnAppLogRule structures.
+//
+// Typical usage:
+//
+//	cfg, err := clients.NewAlertConfigBuilder("pool-x-hm-down", actionGroupRef).
+//		WithCategory(models.AlertConfigCategoryRealtime).
+//		WithMetricsRule("l4_server.avg_complete_conns", 5, models.AlertConfigComparatorIsEqualTo, 0).
+//		Build()
+//
+// There is deliberately no method to scope a built AlertConfig to an
+// existing AlertObjectList: the AlertConfig schema has no field that
+// references one (its three rule kinds match on metrics, system events, or
+// a free-form log filter string, none of which carry an AlertObjectList
+// reference), so no such method can be implemented without inventing a
+// filter format the controller wouldn't understand.
+type AlertConfigBuilder struct {
+	config *models.AlertConfig
+}
+
+// NewAlertConfigBuilder starts building an AlertConfig named name whose
+// instances notify actionGroupRef.
+func NewAlertConfigBuilder(name, actionGroupRef string) *AlertConfigBuilder {
+	return &AlertConfigBuilder{
+		config: &models.AlertConfig{
+			Name:           name,
+			ActionGroupRef: actionGroupRef,
+			Enabled:        true,
+		},
+	}
+}
+
+// WithCategory sets the alert category (REALTIME/ANOMALY/AUDIT_TRAIL).
+func (b *AlertConfigBuilder) WithCategory(category models.AlertConfigCategoryType) *AlertConfigBuilder {
+	b.config.Category = category
+	return b
+}
+
+// WithMetricsRule appends a metrics-based rule comparing metricID, averaged
+// over durationMinutes, against threshold. Multiple calls append further
+// rules and require WithOperator to state how they combine.
+func (b *AlertConfigBuilder) WithMetricsRule(metricID string, durationMinutes uint32, comparator models.AlertConfigComparatorType, threshold uint32) *AlertConfigBuilder {
+	b.config.MetricsRule = append(b.config.MetricsRule, &models.MetricsRule{
+		MetricID: metricID,
+		Duration: durationMinutes,
+		MetricThreshold: &models.MetricThreshold{
+			Comparator: comparator,
+			Threshold:  threshold,
+		},
+	})
+	return b
+}
+
+// WithConnAppLogRule sets the connection/app-log rule, matching log events
+// whose filterAction resolves against filterString.
+func (b *AlertConfigBuilder) WithConnAppLogRule(filterAction, filterString string) *AlertConfigBuilder {
+	b.config.ConnAppLogRule = &models.ConnAppLogRule{
+		FilterAction: filterAction,
+		FilterString: filterString,
+	}
+	return b
+}
+
+// WithOperator states how multiple MetricsRule entries combine (AND/OR).
+func (b *AlertConfigBuilder) WithOperator(operator models.AlertConfigOperatorType) *AlertConfigBuilder {
+	b.config.Operator = operator
+	return b
+}
+
+// WithSysEventRule appends a system-event-based rule matching eventID,
+// optionally negated by notCond and narrowed by details.
+func (b *AlertConfigBuilder) WithSysEventRule(eventID string, notCond bool, details ...*models.EventDetails) *AlertConfigBuilder {
+	b.config.SysEventRule = append(b.config.SysEventRule, &models.SysEventRule{
+		EventID:      eventID,
+		NotCond:      notCond,
+		EventDetails: details,
+	})
+	return b
+}
+
+// WithThreshold sets the number of occurrences, within WithRollingWindow,
+// required before the alert fires.
+func (b *AlertConfigBuilder) WithThreshold(threshold uint32) *AlertConfigBuilder {
+	b.config.Threshold = threshold
+	return b
+}
+
+// WithRollingWindow sets the window, in seconds, over which WithThreshold is evaluated.
+func (b *AlertConfigBuilder) WithRollingWindow(seconds uint32) *AlertConfigBuilder {
+	b.config.RollingWindow = seconds
+	return b
+}
+
+// WithThrottle sets the minimum number of seconds between two successive alert instances.
+func (b *AlertConfigBuilder) WithThrottle(seconds uint32) *AlertConfigBuilder {
+	b.config.Throttle = seconds
+	return b
+}
+
+// WithExpiryTime sets the number of seconds an alert instance stays active after it clears.
+func (b *AlertConfigBuilder) WithExpiryTime(seconds uint32) *AlertConfigBuilder {
+	b.config.ExpiryTime = seconds
+	return b
+}
+
+// WithRecommendation attaches a human-readable recommendation to alert instances.
+func (b *AlertConfigBuilder) WithRecommendation(recommendation string) *AlertConfigBuilder {
+	b.config.Recommendation = recommendation
+	return b
+}
+
+// WithAutoscaleAlert enables automatic scale out/in recommendations when this alert fires.
+func (b *AlertConfigBuilder) WithAutoscaleAlert() *AlertConfigBuilder {
+	b.config.AutoscaleAlert = true
+	return b
+}
+
+// Disabled marks the built AlertConfig as disabled.
+func (b *AlertConfigBuilder) Disabled() *AlertConfigBuilder {
+	b.config.Enabled = false
+	return b
+}
+
+// Build returns the assembled AlertConfig after validating it.
+func (b *AlertConfigBuilder) Build() (*models.AlertConfig, error) {
+	if err := b.config.Validate(); err != nil {
+		return nil, err
+	}
+	return b.config, nil
+}
@@ -0,0 +1,69 @@
+package clients
+
+import (
+	"testing"
+
+	"github.com/matthieugouel/sdk/go/models"
+)
+
+func TestAlertConfigBuilderBuild(t *testing.T) {
+	cfg, err := NewAlertConfigBuilder("pool-x-hm-down", "action-group-x").
+		WithCategory(models.AlertConfigCategoryRealtime).
+		WithMetricsRule("l4_server.avg_complete_conns", 5, models.AlertConfigComparatorIsEqualTo, 0).
+		WithThrottle(300).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() = %v, want nil", err)
+	}
+	if cfg.Name != "pool-x-hm-down" || cfg.ActionGroupRef != "action-group-x" {
+		t.Fatalf("Build() = %+v, unexpected name/action group", cfg)
+	}
+	if !cfg.Enabled {
+		t.Fatal("Build() produced a disabled config by default")
+	}
+	if len(cfg.MetricsRule) != 1 || cfg.MetricsRule[0].MetricID != "l4_server.avg_complete_conns" {
+		t.Fatalf("Build() MetricsRule = %+v, want one rule for the given metric", cfg.MetricsRule)
+	}
+	if cfg.Throttle != 300 {
+		t.Fatalf("Build() Throttle = %d, want 300", cfg.Throttle)
+	}
+}
+
+func TestAlertConfigBuilderDisabled(t *testing.T) {
+	cfg, err := NewAlertConfigBuilder("pool-x-hm-down", "action-group-x").
+		WithCategory(models.AlertConfigCategoryRealtime).
+		WithSysEventRule("HEALTH_MONITOR_DOWN", false).
+		Disabled().
+		Build()
+	if err != nil {
+		t.Fatalf("Build() = %v, want nil", err)
+	}
+	if cfg.Enabled {
+		t.Fatal("Build() = enabled config, want Disabled() to stick")
+	}
+}
+
+func TestAlertConfigBuilderConnAppLogRule(t *testing.T) {
+	cfg, err := NewAlertConfigBuilder("pool-x-log-match", "action-group-x").
+		WithCategory(models.AlertConfigCategoryRealtime).
+		WithConnAppLogRule("stringgroup-x", "some-free-form-filter").
+		Build()
+	if err != nil {
+		t.Fatalf("Build() = %v, want nil", err)
+	}
+	if cfg.ConnAppLogRule == nil {
+		t.Fatal("Build() ConnAppLogRule = nil, want set")
+	}
+	if cfg.ConnAppLogRule.FilterAction != "stringgroup-x" || cfg.ConnAppLogRule.FilterString != "some-free-form-filter" {
+		t.Fatalf("Build() ConnAppLogRule = %+v, unexpected fields", cfg.ConnAppLogRule)
+	}
+}
+
+func TestAlertConfigBuilderBuildValidatesResult(t *testing.T) {
+	// No category and no rules at all: Validate() should reject it, and the
+	// builder should surface that instead of returning an invalid config.
+	_, err := NewAlertConfigBuilder("pool-x-hm-down", "action-group-x").Build()
+	if err == nil {
+		t.Fatal("Build() = nil error, want the underlying Validate() failure")
+	}
+}
@@ -0,0 +1,115 @@
+package clients
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/matthieugouel/sdk/go/models"
+	"github.com/matthieugouel/sdk/go/session"
+)
+
+// alertObjectListURI is the Avi REST collection endpoint for
+// AlertObjectList objects.
+const alertObjectListURI = "/alertobjectlist"
+
+// AlertObjectListClient performs CRUD operations on AlertObjectList objects
+// over an authenticated AviSession.
+type AlertObjectListClient struct {
+	session *session.AviSession
+}
+
+// NewAlertObjectListClient returns a client bound to session.
+func NewAlertObjectListClient(session *session.AviSession) *AlertObjectListClient {
+	return &AlertObjectListClient{session: session}
+}
+
+// CreateAlertObjectList creates obj on the controller and returns the
+// object as stored, including server-assigned fields such as UUID and URL.
+func (c *AlertObjectListClient) CreateAlertObjectList(obj *models.AlertObjectList) (*models.AlertObjectList, error) {
+	result := &models.AlertObjectList{}
+	if err := c.session.Post(alertObjectListURI, obj, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetAlertObjectListByName fetches the AlertObjectList named name.
+func (c *AlertObjectListClient) GetAlertObjectListByName(name string) (*models.AlertObjectList, error) {
+	result := &alertObjectListCollection{}
+	uri := fmt.Sprintf("%s?%s", alertObjectListURI, url.Values{"name": {name}}.Encode())
+	if err := c.session.Get(uri, result); err != nil {
+		return nil, err
+	}
+	if len(result.Results) == 0 {
+		return nil, fmt.Errorf("clients: no AlertObjectList named %q", name)
+	}
+	return result.Results[0], nil
+}
+
+// UpdateAlertObjectList replaces the AlertObjectList identified by
+// obj.UUID with obj.
+func (c *AlertObjectListClient) UpdateAlertObjectList(obj *models.AlertObjectList) (*models.AlertObjectList, error) {
+	if obj.UUID == "" {
+		return nil, fmt.Errorf("clients: UpdateAlertObjectList requires obj.UUID")
+	}
+	result := &models.AlertObjectList{}
+	uri := fmt.Sprintf("%s/%s", alertObjectListURI, obj.UUID)
+	if err := c.session.Put(uri, obj, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteAlertObjectList deletes the AlertObjectList with the given uuid.
+func (c *AlertObjectListClient) DeleteAlertObjectList(uuid string) error {
+	uri := fmt.Sprintf("%s/%s", alertObjectListURI, uuid)
+	return c.session.Delete(uri)
+}
+
+// PatchAlertObjectList applies op to fields on the AlertObjectList
+// identified by uuid, sending only those fields rather than a full
+// GET/modify/PUT round trip. For example, appending a pool UUID to
+// Objects without first fetching the current list:
+//
+//	client.PatchAlertObjectList(uuid, session.PatchAdd, map[string]interface{}{
+//		"objects": []string{"pool-uuid-1"},
+//	})
+func (c *AlertObjectListClient) PatchAlertObjectList(uuid string, op session.PatchOp, fields map[string]interface{}) (*models.AlertObjectList, error) {
+	result := &models.AlertObjectList{}
+	uri := fmt.Sprintf("%s/%s", alertObjectListURI, uuid)
+	if err := c.session.Patch(uri, op, fields, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// alertObjectListCollection is the envelope the controller wraps
+// collection GET results in.
+type alertObjectListCollection struct {
+	Count   int                       `json:"count"`
+	Results []*models.AlertObjectList `json:"results"`
+}
+
+// ListAlertObjectListsReferring returns every AlertObjectList that refers
+// to the object of type objectType identified by uuid, e.g.
+// ListAlertObjectListsReferring(models.AlertObjectListObjectPool, poolUUID)
+// finds every AlertObjectList watching pool P.
+func (c *AlertObjectListClient) ListAlertObjectListsReferring(objectType models.AlertObjectListObjectType, uuid string) ([]*models.AlertObjectList, error) {
+	uri := fmt.Sprintf("%s?%s", alertObjectListURI, session.RefersTo(string(objectType), uuid))
+	result := &alertObjectListCollection{}
+	if err := c.session.Get(uri, result); err != nil {
+		return nil, err
+	}
+	return result.Results, nil
+}
+
+// ListAlertObjectListsReferredBy returns every AlertObjectList referenced
+// by the object of type objectType identified by uuid.
+func (c *AlertObjectListClient) ListAlertObjectListsReferredBy(objectType models.AlertObjectListObjectType, uuid string) ([]*models.AlertObjectList, error) {
+	uri := fmt.Sprintf("%s?%s", alertObjectListURI, session.ReferredBy(string(objectType), uuid))
+	result := &alertObjectListCollection{}
+	if err := c.session.Get(uri, result); err != nil {
+		return nil, err
+	}
+	return result.Results, nil
+}
@@ -0,0 +1,199 @@
+package models
+
+// This file is auto-generated.
+// Please contact avi-sdk@avinetworks.com for any change requests.
+
+// ConnAppLogRule matches connection or application log events by a
+// free-form filter expression.
+// swagger:model ConnAppLogRule
+type ConnAppLogRule struct {
+
+	//  It is a reference to an object of type StringGroup or a free-form filter action.
+	FilterAction string `json:"filter_action,omitempty"`
+
+	// Filter string matched against the log event.
+	// Required: true
+	FilterString string `json:"filter_string"`
+}
+
+// MetricThreshold compares a metric's value against Threshold using Comparator.
+// swagger:model MetricThreshold
+type MetricThreshold struct {
+
+	//  Enum options - IS_LESS_THAN, IS_LESS_THAN_OR_EQUAL_TO, IS_EQUAL_TO, IS_NOT_EQUAL_TO, IS_GREATER_THAN, IS_GREATER_THAN_OR_EQUAL_TO.
+	// Required: true
+	Comparator AlertConfigComparatorType `json:"comparator"`
+
+	// Value the metric is compared against.
+	// Required: true
+	Threshold uint32 `json:"threshold"`
+}
+
+// MetricsRule evaluates MetricID over Duration minutes against MetricThreshold.
+// swagger:model MetricsRule
+type MetricsRule struct {
+
+	// Number of minutes over which the metric is evaluated.
+	Duration uint32 `json:"duration,omitempty"`
+
+	//  It is a reference to an object of type Metric.
+	// Required: true
+	MetricID string `json:"metric_id"`
+
+	// Required: true
+	MetricThreshold *MetricThreshold `json:"metric_threshold"`
+}
+
+// EventDetails matches a single key/value pair carried on a system event.
+// swagger:model EventDetails
+type EventDetails struct {
+
+	//  Enum options - IS_EQUAL_TO, IS_NOT_EQUAL_TO.
+	Comparator AlertConfigComparatorType `json:"comparator,omitempty"`
+
+	// Event detail key to match.
+	// Required: true
+	Key string `json:"key"`
+
+	// Value the event detail key is compared against.
+	// Required: true
+	Value string `json:"value"`
+}
+
+// SysEventRule matches system events by EventID and, optionally, by EventDetails.
+// swagger:model SysEventRule
+type SysEventRule struct {
+
+	// Event details to match, combined with AND semantics.
+	EventDetails []*EventDetails `json:"event_details,omitempty"`
+
+	//  It is a reference to an object of type EventID.
+	// Required: true
+	EventID string `json:"event_id"`
+
+	// Negates the match when true.
+	NotCond bool `json:"not_cond,omitempty"`
+}
+
+// AlertConfig alert config
+// swagger:model AlertConfig
+type AlertConfig struct {
+
+	//  It is a reference to an object of type ActionGroupConfig.
+	// Required: true
+	ActionGroupRef string `json:"action_group_ref"`
+
+	// Enables automatic scale out/in recommendations when this alert fires.
+	AutoscaleAlert bool `json:"autoscale_alert,omitempty"`
+
+	//  Enum options - REALTIME, ANOMALY, AUDIT_TRAIL.
+	// Required: true
+	Category AlertConfigCategoryType `json:"category"`
+
+	// Rule matched against connection or application logs.
+	ConnAppLogRule *ConnAppLogRule `json:"conn_app_log_rule,omitempty"`
+
+	// Enables or disables this alert config without deleting it.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Number of seconds an alert instance stays active after the condition
+	// that raised it clears.
+	ExpiryTime uint32 `json:"expiry_time,omitempty"`
+
+	// Rules matched against metrics, combined by Operator.
+	MetricsRule []*MetricsRule `json:"metrics_rule,omitempty"`
+
+	// Name of the object.
+	// Required: true
+	Name string `json:"name"`
+
+	//  Enum options - AND, OR.
+	Operator AlertConfigOperatorType `json:"operator,omitempty"`
+
+	// Human-readable recommendation attached to alert instances raised by this config.
+	Recommendation string `json:"recommendation,omitempty"`
+
+	// Window, in seconds, over which Threshold is evaluated.
+	RollingWindow uint32 `json:"rolling_window,omitempty"`
+
+	// Rules matched against system events.
+	SysEventRule []*SysEventRule `json:"sys_event_rule,omitempty"`
+
+	//  It is a reference to an object of type Tenant.
+	TenantRef string `json:"tenant_ref,omitempty"`
+
+	// Number of occurrences required, within RollingWindow, before the alert fires.
+	Threshold uint32 `json:"threshold,omitempty"`
+
+	// Minimum number of seconds between two successive alert instances of this config.
+	Throttle uint32 `json:"throttle,omitempty"`
+
+	// url
+	// Read Only: true
+	URL string `json:"url,omitempty"`
+
+	// Unique object identifier of the object.
+	UUID string `json:"uuid,omitempty"`
+}
+
+// Validate rejects an AlertConfig that the controller would otherwise
+// reject with a 400, so that the error surfaces at call time with a
+// field-level message instead of an opaque API response.
+func (o *AlertConfig) Validate() error {
+	errs := &ValidationErrors{}
+
+	if o.Name == "" {
+		errs.Add("name", "is required")
+	}
+	if o.ActionGroupRef == "" {
+		errs.Add("action_group_ref", "is required")
+	}
+
+	if o.Category == "" {
+		errs.Add("category", "is required")
+	} else if !alertConfigCategoryValues[o.Category] {
+		errs.Add("category", "must be one of the AlertConfigCategoryType constants, got %q", o.Category)
+	}
+
+	if o.Operator != "" && !alertConfigOperatorValues[o.Operator] {
+		errs.Add("operator", "must be one of the AlertConfigOperatorType constants, got %q", o.Operator)
+	}
+
+	if len(o.MetricsRule) == 0 && len(o.SysEventRule) == 0 && o.ConnAppLogRule == nil {
+		errs.Add("metrics_rule", "at least one of metrics_rule, sys_event_rule or conn_app_log_rule is required")
+	}
+
+	if len(o.MetricsRule) > 1 && o.Operator == "" {
+		errs.Add("operator", "is required when metrics_rule has more than one entry")
+	}
+
+	for _, rule := range o.MetricsRule {
+		if rule == nil {
+			errs.Add("metrics_rule", "entries must not be nil")
+			continue
+		}
+		if rule.MetricThreshold == nil {
+			errs.Add("metrics_rule.metric_threshold", "is required")
+			continue
+		}
+		if !alertConfigComparatorValues[rule.MetricThreshold.Comparator] {
+			errs.Add("metrics_rule.metric_threshold.comparator", "must be one of the AlertConfigComparatorType constants, got %q", rule.MetricThreshold.Comparator)
+		}
+	}
+
+	for _, rule := range o.SysEventRule {
+		if rule == nil {
+			errs.Add("sys_event_rule", "entries must not be nil")
+			continue
+		}
+		if rule.EventID == "" {
+			errs.Add("sys_event_rule.event_id", "is required")
+		}
+	}
+
+	if o.ConnAppLogRule != nil && o.ConnAppLogRule.FilterString == "" {
+		errs.Add("conn_app_log_rule.filter_string", "is required")
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,64 @@
+package models
+
+// Hand-written, mirroring the style of the gen/main.go-generated enum
+// files. gen/main.go pairs every "Enum options" comment in a file with the
+// field that follows it, whichever struct that field belongs to, and names
+// the type after -model and the field. On alert_config.go that would emit
+// AlertConfigComparatorType twice (for MetricThreshold.Comparator and
+// EventDetails.Comparator, with different option lists), so these
+// constants aren't produced by `go generate` — keep them in sync with
+// alert_config.go's "Enum options" comments by hand.
+
+// AlertConfigCategoryType is the typed enum for AlertConfig.Category.
+type AlertConfigCategoryType string
+
+// AlertConfig Category enum options.
+const (
+	AlertConfigCategoryRealtime   AlertConfigCategoryType = "REALTIME"
+	AlertConfigCategoryAnomaly    AlertConfigCategoryType = "ANOMALY"
+	AlertConfigCategoryAuditTrail AlertConfigCategoryType = "AUDIT_TRAIL"
+)
+
+var alertConfigCategoryValues = map[AlertConfigCategoryType]bool{
+	AlertConfigCategoryAnomaly:    true,
+	AlertConfigCategoryAuditTrail: true,
+	AlertConfigCategoryRealtime:   true,
+}
+
+// AlertConfigOperatorType is the typed enum for AlertConfig.Operator, used
+// to combine multiple MetricsRule entries.
+type AlertConfigOperatorType string
+
+// AlertConfig Operator enum options.
+const (
+	AlertConfigOperatorAnd AlertConfigOperatorType = "AND"
+	AlertConfigOperatorOr  AlertConfigOperatorType = "OR"
+)
+
+var alertConfigOperatorValues = map[AlertConfigOperatorType]bool{
+	AlertConfigOperatorAnd: true,
+	AlertConfigOperatorOr:  true,
+}
+
+// AlertConfigComparatorType is the typed enum shared by MetricThreshold.Comparator
+// and EventDetails.Comparator.
+type AlertConfigComparatorType string
+
+// Comparator enum options.
+const (
+	AlertConfigComparatorIsLessThan             AlertConfigComparatorType = "IS_LESS_THAN"
+	AlertConfigComparatorIsLessThanOrEqualTo    AlertConfigComparatorType = "IS_LESS_THAN_OR_EQUAL_TO"
+	AlertConfigComparatorIsEqualTo              AlertConfigComparatorType = "IS_EQUAL_TO"
+	AlertConfigComparatorIsNotEqualTo           AlertConfigComparatorType = "IS_NOT_EQUAL_TO"
+	AlertConfigComparatorIsGreaterThan          AlertConfigComparatorType = "IS_GREATER_THAN"
+	AlertConfigComparatorIsGreaterThanOrEqualTo AlertConfigComparatorType = "IS_GREATER_THAN_OR_EQUAL_TO"
+)
+
+var alertConfigComparatorValues = map[AlertConfigComparatorType]bool{
+	AlertConfigComparatorIsLessThan:             true,
+	AlertConfigComparatorIsLessThanOrEqualTo:    true,
+	AlertConfigComparatorIsEqualTo:              true,
+	AlertConfigComparatorIsNotEqualTo:           true,
+	AlertConfigComparatorIsGreaterThan:          true,
+	AlertConfigComparatorIsGreaterThanOrEqualTo: true,
+}
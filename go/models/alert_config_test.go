@@ -0,0 +1,125 @@
+package models
+
+import "testing"
+
+func validAlertConfig() *AlertConfig {
+	return &AlertConfig{
+		Name:           "pool-x-hm-down",
+		ActionGroupRef: "action-group-x",
+		Category:       AlertConfigCategoryRealtime,
+		MetricsRule: []*MetricsRule{
+			{
+				MetricID: "l4_server.avg_complete_conns",
+				MetricThreshold: &MetricThreshold{
+					Comparator: AlertConfigComparatorIsEqualTo,
+					Threshold:  0,
+				},
+			},
+		},
+	}
+}
+
+func TestAlertConfigValidate(t *testing.T) {
+	if err := validAlertConfig().Validate(); err != nil {
+		t.Fatalf("Validate() = %v, want nil", err)
+	}
+
+	t.Run("missing name", func(t *testing.T) {
+		o := validAlertConfig()
+		o.Name = ""
+		if err := o.Validate(); err == nil {
+			t.Fatal("Validate() = nil, want error")
+		}
+	})
+
+	t.Run("missing action group ref", func(t *testing.T) {
+		o := validAlertConfig()
+		o.ActionGroupRef = ""
+		if err := o.Validate(); err == nil {
+			t.Fatal("Validate() = nil, want error")
+		}
+	})
+
+	t.Run("unknown category", func(t *testing.T) {
+		o := validAlertConfig()
+		o.Category = AlertConfigCategoryType("NOT_A_CATEGORY")
+		if err := o.Validate(); err == nil {
+			t.Fatal("Validate() = nil, want error")
+		}
+	})
+
+	t.Run("no rules at all", func(t *testing.T) {
+		o := validAlertConfig()
+		o.MetricsRule = nil
+		if err := o.Validate(); err == nil {
+			t.Fatal("Validate() = nil, want error")
+		}
+	})
+
+	t.Run("multiple metrics rules require operator", func(t *testing.T) {
+		o := validAlertConfig()
+		o.MetricsRule = append(o.MetricsRule, &MetricsRule{
+			MetricID:        "l4_server.avg_rx_bytes",
+			MetricThreshold: &MetricThreshold{Comparator: AlertConfigComparatorIsGreaterThan, Threshold: 1000},
+		})
+		if err := o.Validate(); err == nil {
+			t.Fatal("Validate() = nil, want error")
+		}
+		o.Operator = AlertConfigOperatorOr
+		if err := o.Validate(); err != nil {
+			t.Fatalf("Validate() = %v, want nil once operator is set", err)
+		}
+	})
+
+	t.Run("unknown comparator", func(t *testing.T) {
+		o := validAlertConfig()
+		o.MetricsRule[0].MetricThreshold.Comparator = AlertConfigComparatorType("NOT_A_COMPARATOR")
+		if err := o.Validate(); err == nil {
+			t.Fatal("Validate() = nil, want error")
+		}
+	})
+
+	t.Run("nil metrics rule entry does not panic", func(t *testing.T) {
+		o := validAlertConfig()
+		o.MetricsRule = append(o.MetricsRule, nil)
+		o.Operator = AlertConfigOperatorOr
+		if err := o.Validate(); err == nil {
+			t.Fatal("Validate() = nil, want error")
+		}
+	})
+
+	t.Run("missing metric threshold is rejected", func(t *testing.T) {
+		o := validAlertConfig()
+		o.MetricsRule[0].MetricThreshold = nil
+		if err := o.Validate(); err == nil {
+			t.Fatal("Validate() = nil, want error")
+		}
+	})
+
+	t.Run("nil sys event rule entry does not panic", func(t *testing.T) {
+		o := validAlertConfig()
+		o.MetricsRule = nil
+		o.SysEventRule = []*SysEventRule{nil}
+		if err := o.Validate(); err == nil {
+			t.Fatal("Validate() = nil, want error")
+		}
+	})
+
+	t.Run("missing sys event id is rejected", func(t *testing.T) {
+		o := validAlertConfig()
+		o.MetricsRule = nil
+		o.SysEventRule = []*SysEventRule{{NotCond: true}}
+		if err := o.Validate(); err == nil {
+			t.Fatal("Validate() = nil, want error")
+		}
+	})
+
+	t.Run("missing conn app log filter string is rejected", func(t *testing.T) {
+		o := validAlertConfig()
+		o.MetricsRule = nil
+		o.ConnAppLogRule = &ConnAppLogRule{FilterAction: "some-string-group-ref"}
+		if err := o.Validate(); err == nil {
+			t.Fatal("Validate() = nil, want error")
+		}
+	})
+}
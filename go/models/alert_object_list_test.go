@@ -0,0 +1,60 @@
+package models
+
+import "testing"
+
+func TestAlertObjectListValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		obj     *AlertObjectList
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			obj: &AlertObjectList{
+				Name:    "pool-x-watch",
+				Source:  AlertObjectListSourceMetrics,
+				Objects: []AlertObjectListObjectType{AlertObjectListObjectPool, AlertObjectListObjectHealthMonitor},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "missing name",
+			obj:     &AlertObjectList{Source: AlertObjectListSourceMetrics},
+			wantErr: true,
+		},
+		{
+			name:    "missing source",
+			obj:     &AlertObjectList{Name: "pool-x-watch"},
+			wantErr: true,
+		},
+		{
+			name: "unknown source",
+			obj: &AlertObjectList{
+				Name:   "pool-x-watch",
+				Source: AlertObjectListSourceType("NOT_A_SOURCE"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown object type",
+			obj: &AlertObjectList{
+				Name:    "pool-x-watch",
+				Source:  AlertObjectListSourceMetrics,
+				Objects: []AlertObjectListObjectType{AlertObjectListObjectType("NOT_AN_OBJECT")},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.obj.Validate()
+			if tc.wantErr && err == nil {
+				t.Fatalf("Validate() = nil, want error")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("Validate() = %v, want nil", err)
+			}
+		})
+	}
+}
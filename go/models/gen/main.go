@@ -0,0 +1,248 @@
+// Command enumgen regenerates typed Go enum constants (and the value-set
+// maps used by Validate()) from the "Enum options - ..." swagger comments
+// that the upstream model generator emits on string fields.
+//
+// It is invoked via `go generate` from the doc comment above each model
+// struct, e.g.:
+//
+//	//go:generate go run ./gen -model AlertObjectList -out alert_object_list_enums.go
+//
+// Today it only understands AlertObjectList; extending it to the rest of
+// the module is a matter of adding a -model entry per generated file as
+// each model grows its own Validate().
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// wordList is used to split SCREAMING_CASE swagger enum tokens (e.g.
+// "VIRTUALSERVICE") back into the words Go identifiers expect
+// ("VirtualService"). The split with the fewest words wins; a token that
+// cannot be covered entirely by known words falls back to a single
+// Title-cased word, which keeps the generator usable even before its
+// dictionary has learned a new compound.
+var wordList = []string{
+	"VIRTUAL", "SERVICE", "POOL", "HEALTH", "MONITOR", "NETWORK", "PROFILE",
+	"APPLICATION", "HTTP", "POLICY", "SET", "DNS", "IP", "ADDR", "GROUP",
+	"STRING", "SSL", "KEY", "AND", "CERTIFICATE", "SECURITY", "PERSISTENCE",
+	"ANALYTICS", "VS", "DATA", "SCRIPT", "TENANT", "PKI", "AUTH", "CLOUD",
+	"SERVER", "AUTOSCALE", "LAUNCH", "CONFIG", "MICRO", "IPAM", "HARDWARE",
+	"MODULE", "PRIORITY", "LABELS", "DEPLOYMENT", "GSLB", "RUNTIME",
+	"SCHEDULER", "GEODB", "GEO", "DB", "TRAFFIC", "CLONE", "VIP", "WAF",
+	"ERROR", "PAGE", "BODY", "L4", "L7", "ENGINE", "DEBUG", "CONTROLLER",
+	"NODE", "PROPERTIES", "SYSTEM", "CONFIGURATION", "VRF", "CONTEXT",
+	"USER", "ALERT", "SYSLOG", "EMAIL", "TYPE", "ROLE", "SNMP", "TRAP",
+	"ACTION", "STATS", "STAT", "GROUPS", "PARAMS", "CONNECTOR", "SITE",
+	"OPS", "WARM", "START", "RECORD", "STATUS", "FILE", "CLEANUP",
+	"RESYNC", "TCP", "UDP", "ARP", "MB", "STK", "QS", "MALLOC", "SHM",
+	"CPU", "USAGE", "GLOBAL", "INTERNAL", "MESSAGE", "ENTITY", "DUMP",
+	"CLEAR", "TABLE", "REMOTE", "TIMER", "LIST", "AGENT", "VNIC", "GRAPH",
+	"STATE", "MACHINE", "INTERFACE", "DISPATCHER", "SHARED", "ICMP",
+	"ROUTE", "MEM", "INFO", "RING", "ALGO", "HOST", "PORT", "CLUSTER",
+	"DATACENTER", "VCENTER", "RATE", "LIMITER", "DETAIL", "SUMMARY",
+	"HASH", "SHOW", "LOG", "LOGS", "TEMP", "DISABLE", "REQ", "SCALE",
+	"OUT", "DIST", "UPGRADE", "PREVIEW", "FAULT", "INJECT", "EXHAUST",
+	"CONN", "SMALL", "HEADLESS", "ONLINE", "RESERVED", "CANDIDATE",
+	"REBALANCE", "FILTER", "UPDATE", "INSERTION", "CACHE", "DOS",
+	"MIGRATE", "ALL", "SUBFOLDERS", "STORE", "RESOURCES", "LACP", "LLDP",
+	"SHARE", "SHARING", "PLACEMENT", "VM", "CREATE", "PROGRESS", "VI",
+	"SUBNET", "VMS", "CONTENTS", "PORTGROUP", "TRANSACTION", "FLAP",
+	"INSTANCES", "EPGS", "EPS", "DEVICE", "PKG", "VER", "TENANTS", "VMM",
+	"DOMAINS", "NSX", "SG", "IPS", "CLI", "VAL", "TRACK", "DEFINED",
+	"DATASCRIPT", "COUNTERS", "LOCATION", "SUPPORTED", "ANALYSIS",
+	"ROLES", "REQUEST", "RESOURCE", "PROTO", "CONSUMER", "PENDING", "RM",
+	"MAP",
+}
+
+// splitWords tokenizes a SCREAMING_CASE enum value into the shortest
+// sequence of known words that covers it exactly, falling back to the whole
+// token as one word when no such sequence exists in wordList.
+func splitWords(token string) []string {
+	n := len(token)
+	best := make([][]string, n+1)
+	best[0] = []string{}
+	for i := 1; i <= n; i++ {
+		for _, w := range wordList {
+			lw := len(w)
+			if lw > i || best[i-lw] == nil || token[i-lw:i] != w {
+				continue
+			}
+			cand := append(append([]string{}, best[i-lw]...), w)
+			if best[i] == nil || len(cand) < len(best[i]) {
+				best[i] = cand
+			}
+		}
+	}
+	if best[n] == nil {
+		return []string{token}
+	}
+	return best[n]
+}
+
+func camelCase(words []string) string {
+	var sb strings.Builder
+	for _, w := range words {
+		sb.WriteString(strings.ToUpper(w[:1]))
+		sb.WriteString(strings.ToLower(w[1:]))
+	}
+	return sb.String()
+}
+
+// enumComment matches a swagger "Enum options - A, B, C." doc comment line.
+var enumComment = regexp.MustCompile(`Enum options - (.+)\.\s*$`)
+
+// docCommentLine matches any doc-comment line, such as the "Required: true"
+// line swagger emits between an Enum options comment and the field it
+// documents (see alert_object_list.go's Source field). These must be
+// skipped without losing the pending enum values, or the field pairing
+// below silently drops the field.
+var docCommentLine = regexp.MustCompile(`^\s*//`)
+
+// fieldDecl matches the Go struct field declaration that the enum comment
+// immediately precedes, e.g. `	Source string \`json:"source"\`` or, once a
+// field has already been migrated to its generated enum type, `	Source
+// AlertObjectListSourceType \`json:"source"\``. It deliberately accepts any
+// field type rather than just string/[]string so it keeps matching across
+// regenerations instead of only finding the field the first time.
+var fieldDecl = regexp.MustCompile(`^\s*(\w+)\s+(\S+)\s+` + "`json:")
+
+type enumField struct {
+	name   string // Go field name, e.g. "Source"
+	slice  bool   // true for slice fields such as Objects
+	values []string
+}
+
+func parseEnumFields(path string) ([]enumField, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var fields []enumField
+	var pending []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := enumComment.FindStringSubmatch(line); m != nil {
+			pending = strings.Split(m[1], ", ")
+			continue
+		}
+		if pending == nil {
+			continue
+		}
+		if docCommentLine.MatchString(line) {
+			// Another doc-comment line, e.g. "Required: true", sitting
+			// between the enum comment and the field it documents: keep
+			// waiting for the actual field declaration.
+			continue
+		}
+		if m := fieldDecl.FindStringSubmatch(line); m != nil {
+			fields = append(fields, enumField{
+				name:   m[1],
+				slice:  strings.HasPrefix(m[2], "[]"),
+				values: pending,
+			})
+		}
+		pending = nil
+	}
+	return fields, scanner.Err()
+}
+
+func main() {
+	model := flag.String("model", "", "model struct name, e.g. AlertObjectList")
+	out := flag.String("out", "", "output file, written relative to this package's directory")
+	flag.Parse()
+
+	if *model == "" || *out == "" {
+		log.Fatal("usage: enumgen -model <Name> -out <file.go>")
+	}
+
+	srcFile := toSnake(*model) + ".go"
+	fields, err := parseEnumFields(srcFile)
+	if err != nil {
+		log.Fatalf("reading %s: %v", srcFile, err)
+	}
+	if len(fields) == 0 {
+		log.Fatalf("no enum fields found in %s", srcFile)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "package models\n\n")
+	fmt.Fprintf(&sb, "// Code generated by go/models/gen (enumgen); DO NOT EDIT.\n")
+	fmt.Fprintf(&sb, "// Source: swagger enum options on %s.\n", *model)
+	fmt.Fprintf(&sb, "// To regenerate: go generate ./go/models/...\n\n")
+
+	for _, field := range fields {
+		// A slice field holds one enum value per entry, so its type and
+		// constants take the singular name (Objects -> Object).
+		enumName := field.name
+		entries := ""
+		if field.slice {
+			enumName = strings.TrimSuffix(enumName, "s")
+			entries = "entries of "
+		}
+		typeName := fmt.Sprintf("%s%sType", *model, enumName)
+		fmt.Fprintf(&sb, "// %s is the typed enum for %s%s.%s.\n", typeName, entries, *model, field.name)
+		fmt.Fprintf(&sb, "type %s string\n\n", typeName)
+		fmt.Fprintf(&sb, "// %s %s enum options.\n", *model, field.name)
+		fmt.Fprintf(&sb, "const (\n")
+		names := make([]string, 0, len(field.values))
+		for _, v := range field.values {
+			// Underscores separate words already (CONN_LOGS -> ConnLogs).
+			var words []string
+			for _, part := range strings.Split(v, "_") {
+				words = append(words, splitWords(part)...)
+			}
+			name := fmt.Sprintf("%s%s%s", *model, enumName, camelCase(words))
+			names = append(names, name)
+			fmt.Fprintf(&sb, "\t%s %s = %q\n", name, typeName, v)
+		}
+		fmt.Fprintf(&sb, ")\n\n")
+
+		varName := fmt.Sprintf("%s%sValues", lowerFirst(*model), enumName)
+		fmt.Fprintf(&sb, "var %s = map[%s]bool{\n", varName, typeName)
+		sorted := append([]string{}, names...)
+		sort.Strings(sorted)
+		for _, name := range sorted {
+			fmt.Fprintf(&sb, "\t%s: true,\n", name)
+		}
+		fmt.Fprintf(&sb, "}\n\n")
+	}
+
+	formatted, err := format.Source([]byte(sb.String()))
+	if err != nil {
+		log.Fatalf("formatting generated source: %v", err)
+	}
+
+	if err := os.WriteFile(*out, formatted, 0644); err != nil {
+		log.Fatalf("writing %s: %v", *out, err)
+	}
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+func toSnake(s string) string {
+	var sb strings.Builder
+	for i, r := range s {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			sb.WriteByte('_')
+		}
+		sb.WriteRune(r)
+	}
+	return strings.ToLower(sb.String())
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitWords(t *testing.T) {
+	cases := []struct {
+		token string
+		want  []string
+	}{
+		{"VIRTUALSERVICE", []string{"VIRTUAL", "SERVICE"}},
+		{"POOL", []string{"POOL"}},
+		{"NETWORKSECURITYPOLICY", []string{"NETWORK", "SECURITY", "POLICY"}},
+		{"TOTALLYUNKNOWNTOKEN", []string{"TOTALLYUNKNOWNTOKEN"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.token, func(t *testing.T) {
+			got := splitWords(tc.token)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("splitWords(%q) = %v, want %v", tc.token, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCamelCase(t *testing.T) {
+	got := camelCase([]string{"VIRTUAL", "SERVICE"})
+	if got != "VirtualService" {
+		t.Fatalf("camelCase(...) = %q, want %q", got, "VirtualService")
+	}
+}
+
+func TestParseEnumFieldsMatchesMigratedTypedFields(t *testing.T) {
+	// Regression test: once a field is migrated from string/[]string to its
+	// generated enum type, fieldDecl must still match it so `go generate`
+	// keeps working across regenerations instead of only on the first run.
+	cases := []struct {
+		line string
+		want string
+	}{
+		{`	Source AlertObjectListSourceType ` + "`json:\"source\"`", "Source"},
+		{`	Objects []AlertObjectListObjectType ` + "`json:\"objects,omitempty\"`", "Objects"},
+		{`	Source string ` + "`json:\"source\"`", "Source"},
+	}
+
+	for _, tc := range cases {
+		m := fieldDecl.FindStringSubmatch(tc.line)
+		if m == nil {
+			t.Fatalf("fieldDecl did not match %q", tc.line)
+		}
+		if m[1] != tc.want {
+			t.Fatalf("fieldDecl matched field %q, want %q", m[1], tc.want)
+		}
+	}
+}
+
+func TestParseEnumFieldsRealModel(t *testing.T) {
+	// Regression test: alert_object_list.go's Source field has a "Required:
+	// true" doc-comment line between its Enum options comment and the field
+	// declaration. Replaying parseEnumFields against the real file (not a
+	// synthetic one-line fixture) catches pairing logic that only works when
+	// the two lines are adjacent.
+	fields, err := parseEnumFields("../alert_object_list.go")
+	if err != nil {
+		t.Fatalf("parseEnumFields(alert_object_list.go) = %v", err)
+	}
+
+	got := map[string]bool{}
+	for _, f := range fields {
+		got[f.name] = true
+	}
+	for _, want := range []string{"Objects", "Source"} {
+		if !got[want] {
+			t.Fatalf("parseEnumFields(alert_object_list.go) = %v, missing field %q", fields, want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validator is implemented by any model that can check itself against the
+// constraints the Avi controller would otherwise enforce server-side (enum
+// membership, required fields, ...). Client code should call Validate()
+// before POST/PUT so misconfigurations fail locally instead of as an opaque
+// 400 from the controller.
+type Validator interface {
+	Validate() error
+}
+
+// FieldError is a single field-level validation failure.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidationErrors accumulates FieldErrors for a single model so that all
+// violations are reported together instead of failing on the first one.
+type ValidationErrors struct {
+	Errors []*FieldError
+}
+
+// Add records a field-level violation. format/args follow fmt.Sprintf.
+func (e *ValidationErrors) Add(field, format string, args ...interface{}) {
+	e.Errors = append(e.Errors, &FieldError{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// ErrorOrNil returns e as an error if it holds any violations, or nil
+// otherwise. This lets Validate() implementations end with
+// `return errs.ErrorOrNil()` regardless of whether anything was added.
+func (e *ValidationErrors) ErrorOrNil() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, fe := range e.Errors {
+		msgs = append(msgs, fe.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
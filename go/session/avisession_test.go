@@ -0,0 +1,81 @@
+package session
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestSession(t *testing.T, handler http.HandlerFunc) *AviSession {
+	t.Helper()
+	srv := httptest.NewTLSServer(handler)
+	t.Cleanup(srv.Close)
+
+	s, err := NewAviSession(srv.Listener.Addr().String(), "admin", SetInsecure())
+	if err != nil {
+		t.Fatalf("NewAviSession() = %v", err)
+	}
+	return s
+}
+
+func TestPatchRequestBodyShape(t *testing.T) {
+	var gotMethod string
+	var gotBody map[string]interface{}
+
+	s := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		w.Write([]byte("{}"))
+	})
+
+	err := s.Patch("/alertobjectlist/uuid-1", PatchAdd, map[string]interface{}{
+		"objects": []string{"pool-uuid-1"},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Patch() = %v", err)
+	}
+
+	if gotMethod != http.MethodPatch {
+		t.Fatalf("Patch() method = %q, want %q", gotMethod, http.MethodPatch)
+	}
+
+	want := map[string]interface{}{
+		"add": map[string]interface{}{
+			"objects": []interface{}{"pool-uuid-1"},
+		},
+	}
+	wantJSON, _ := json.Marshal(want)
+	gotJSON, _ := json.Marshal(gotBody)
+	if string(gotJSON) != string(wantJSON) {
+		t.Fatalf("Patch() body = %s, want %s", gotJSON, wantJSON)
+	}
+}
+
+func TestSetAPIContextSeedsCookieJar(t *testing.T) {
+	var gotCookie string
+	var cookieCount int
+
+	s := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		cookieCount = len(r.Cookies())
+		if c, err := r.Cookie("avi-sessionid"); err == nil {
+			gotCookie = c.Value
+		}
+		w.Write([]byte("{}"))
+	})
+
+	SetAPIContext(&APIContext{SessionID: "imported-session-id", CSRFToken: "imported-csrf"})(s)
+
+	if err := s.Get("/alertconfig/uuid-1", nil); err != nil {
+		t.Fatalf("Get() = %v", err)
+	}
+
+	if gotCookie != "imported-session-id" {
+		t.Fatalf("avi-sessionid cookie = %q, want %q", gotCookie, "imported-session-id")
+	}
+	if cookieCount != 1 {
+		t.Fatalf("request carried %d cookies, want exactly 1 (no duplicate avi-sessionid)", cookieCount)
+	}
+}
@@ -0,0 +1,22 @@
+package session
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// RefersTo builds the refers_to query parameter for a collection GET, e.g.
+// RefersTo("pool", poolUUID) restricts the list to objects that reference
+// the pool with poolUUID. objectType is a plain string so this helper works
+// for every model in the module; generated clients should accept a typed
+// enum at their own call sites and pass its string value here.
+func RefersTo(objectType, uuid string) string {
+	return url.Values{"refers_to": {fmt.Sprintf("%s:%s", objectType, uuid)}}.Encode()
+}
+
+// ReferredBy builds the referred_by query parameter for a collection GET,
+// e.g. ReferredBy("pool", poolUUID) restricts the list to objects
+// referenced by the pool with poolUUID.
+func ReferredBy(objectType, uuid string) string {
+	return url.Values{"referred_by": {fmt.Sprintf("%s:%s", objectType, uuid)}}.Encode()
+}
@@ -0,0 +1,24 @@
+package session
+
+import "testing"
+
+func TestRefersTo(t *testing.T) {
+	got := RefersTo("pool", "pool-uuid-1")
+	if want := "refers_to=pool%3Apool-uuid-1"; got != want {
+		t.Fatalf("RefersTo() = %q, want %q", got, want)
+	}
+}
+
+func TestReferredBy(t *testing.T) {
+	got := ReferredBy("pool", "pool-uuid-1")
+	if want := "referred_by=pool%3Apool-uuid-1"; got != want {
+		t.Fatalf("ReferredBy() = %q, want %q", got, want)
+	}
+}
+
+func TestRefersToEscapesSpecialCharacters(t *testing.T) {
+	got := RefersTo("pool", "uuid-with-&-and-#-and-%")
+	if want := "refers_to=pool%3Auuid-with-%26-and-%23-and-%25"; got != want {
+		t.Fatalf("RefersTo() = %q, want %q", got, want)
+	}
+}